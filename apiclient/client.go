@@ -0,0 +1,283 @@
+// Package apiclient fetches validatorSummaries from the Hyperliquid API with retry,
+// per-endpoint circuit breaking, and automatic failover across a list of endpoints.
+package apiclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Validator represents the structure of validator data from the API response
+type Validator struct {
+	Validator       string `json:"validator"`       // Address of the validator
+	Name            string `json:"name"`            // Name of the validator
+	IsJailed        bool   `json:"isJailed"`        // Indicates if validator is jailed
+	IsActive        bool   `json:"isActive"`        // Indicates if validator is active
+	Commission      string `json:"commission"`      // Commission rate charged by validator
+	UnjailableAfter *int64 `json:"unjailableAfter"` // Timestamp when validator can be unjailed (null if not jailed)
+}
+
+// Default tuning for retry and circuit breaking. Exposed as package vars rather than
+// client options since a single monitor process only ever needs one policy.
+var (
+	MaxRetries           = 3
+	RetryBaseDelay       = 500 * time.Millisecond
+	BreakerFailThreshold = 5                // consecutive failures before an endpoint's circuit opens
+	BreakerCooldown      = 30 * time.Second // how long an open circuit stays open before retrying
+)
+
+// endpointState tracks circuit breaker bookkeeping for a single endpoint.
+type endpointState struct {
+	consecutiveFailures int
+	openUntil           time.Time
+	lastError           error
+}
+
+// EndpointHealth is the read-only snapshot of an endpoint's circuit breaker state,
+// exposed so callers can surface it via metrics or logs.
+type EndpointHealth struct {
+	Endpoint            string
+	Open                bool
+	ConsecutiveFailures int
+	LastError           error
+}
+
+// Client fetches validatorSummaries from a prioritized list of Hyperliquid API
+// endpoints, failing over to the next endpoint when one is unhealthy.
+type Client struct {
+	endpoints  []string
+	httpClient *http.Client
+
+	mu           sync.Mutex
+	health       map[string]*endpointState
+	allDownSince time.Time // zero value means the API is currently reachable
+}
+
+// NewClient builds a Client that tries endpoints in order, falling back to the next one
+// on failure. The first endpoint is treated as primary; the rest are backups.
+func NewClient(endpoints []string) *Client {
+	if len(endpoints) == 0 {
+		panic("apiclient: at least one endpoint is required")
+	}
+
+	health := make(map[string]*endpointState, len(endpoints))
+	for _, e := range endpoints {
+		health[e] = &endpointState{}
+	}
+
+	return &Client{
+		endpoints:  endpoints,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		health:     health,
+	}
+}
+
+// FetchValidatorSummaries tries each configured endpoint in order, retrying transient
+// failures with jittered exponential backoff before failing over to the next endpoint.
+func (c *Client) FetchValidatorSummaries() ([]Validator, error) {
+	var lastErr error
+
+	for _, endpoint := range c.endpoints {
+		if c.circuitOpen(endpoint) {
+			log.Printf("apiclient: skipping %s, circuit breaker open", endpoint)
+			continue
+		}
+
+		validators, err := c.fetchWithRetry(endpoint)
+		if err == nil {
+			c.recordSuccess(endpoint)
+			c.clearAllDown()
+			return validators, nil
+		}
+
+		c.recordFailure(endpoint, err)
+		lastErr = err
+		log.Printf("apiclient: endpoint %s failed, trying next: %v", endpoint, err)
+	}
+
+	c.markAllDown()
+	if lastErr == nil {
+		lastErr = fmt.Errorf("no endpoints available")
+	}
+	return nil, fmt.Errorf("all API endpoints failed: %w", lastErr)
+}
+
+// fetchWithRetry performs a single endpoint fetch, retrying transient failures (network
+// errors, timeouts, 5xx) with jittered exponential backoff. Non-transient failures (bad
+// request, malformed body) return immediately without retrying.
+func (c *Client) fetchWithRetry(endpoint string) ([]Validator, error) {
+	var lastErr error
+
+	for attempt := 1; attempt <= MaxRetries; attempt++ {
+		validators, err := fetchOnce(c.httpClient, endpoint)
+		if err == nil {
+			return validators, nil
+		}
+
+		lastErr = err
+		if !isTransient(err) {
+			return nil, err
+		}
+
+		if attempt < MaxRetries {
+			delay := RetryBaseDelay * time.Duration(1<<uint(attempt-1))
+			delay += time.Duration(rand.Int63n(int64(RetryBaseDelay)))
+			time.Sleep(delay)
+		}
+	}
+
+	return nil, lastErr
+}
+
+// transientError wraps an error known to be worth retrying (network failure, timeout,
+// 5xx response).
+type transientError struct{ err error }
+
+func (t *transientError) Error() string { return t.err.Error() }
+func (t *transientError) Unwrap() error { return t.err }
+
+func isTransient(err error) bool {
+	_, ok := err.(*transientError)
+	return ok
+}
+
+// fetchOnce performs a single, non-retried validatorSummaries request.
+func fetchOnce(httpClient *http.Client, endpoint string) ([]Validator, error) {
+	payload := []byte(`{"type":"validatorSummaries"}`)
+	req, err := http.NewRequest("POST", endpoint, bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, &transientError{fmt.Errorf("API request failed: %w", err)}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 500 {
+		return nil, &transientError{fmt.Errorf("API returned status: %s", resp.Status)}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("API returned non-200 status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, &transientError{fmt.Errorf("error reading response body: %w", err)}
+	}
+
+	var validators []Validator
+	if err := json.Unmarshal(body, &validators); err != nil {
+		return nil, fmt.Errorf("error parsing API response: %w", err)
+	}
+
+	return validators, nil
+}
+
+// circuitOpen reports whether endpoint's breaker is currently tripped.
+func (c *Client) circuitOpen(endpoint string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := c.health[endpoint]
+	return state.consecutiveFailures >= BreakerFailThreshold && time.Now().Before(state.openUntil)
+}
+
+// recordFailure increments an endpoint's failure count, tripping its breaker once
+// BreakerFailThreshold consecutive failures have been observed.
+func (c *Client) recordFailure(endpoint string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := c.health[endpoint]
+	state.consecutiveFailures++
+	state.lastError = err
+	if state.consecutiveFailures >= BreakerFailThreshold {
+		state.openUntil = time.Now().Add(BreakerCooldown)
+	}
+}
+
+// recordSuccess resets an endpoint's breaker after a successful fetch.
+func (c *Client) recordSuccess(endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state := c.health[endpoint]
+	state.consecutiveFailures = 0
+	state.openUntil = time.Time{}
+	state.lastError = nil
+}
+
+// markAllDown records the first moment every endpoint was observed failing in the same
+// cycle, so callers can alert once the API has been unreachable for too long.
+func (c *Client) markAllDown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.allDownSince.IsZero() {
+		c.allDownSince = time.Now()
+	}
+}
+
+// clearAllDown resets the all-endpoints-down tracking once any fetch succeeds.
+func (c *Client) clearAllDown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.allDownSince = time.Time{}
+}
+
+// DownSince reports when every endpoint started failing continuously. ok is false when
+// the API is currently reachable.
+func (c *Client) DownSince() (since time.Time, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.allDownSince.IsZero() {
+		return time.Time{}, false
+	}
+	return c.allDownSince, true
+}
+
+// Health returns a snapshot of every endpoint's circuit breaker state, for exposing via
+// metrics or startup/debug logs.
+func (c *Client) Health() []EndpointHealth {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := make([]EndpointHealth, 0, len(c.endpoints))
+	for _, endpoint := range c.endpoints {
+		state := c.health[endpoint]
+		snapshot = append(snapshot, EndpointHealth{
+			Endpoint:            endpoint,
+			Open:                state.consecutiveFailures >= BreakerFailThreshold && time.Now().Before(state.openUntil),
+			ConsecutiveFailures: state.consecutiveFailures,
+			LastError:           state.lastError,
+		})
+	}
+	return snapshot
+}
+
+// FindValidator looks up a single validator by address (case-insensitive) within an
+// already-fetched validatorSummaries response.
+func FindValidator(allValidators []Validator, validatorAddress string) (*Validator, error) {
+	lowercaseInputAddress := strings.ToLower(validatorAddress)
+	for _, val := range allValidators {
+		if strings.ToLower(val.Validator) == lowercaseInputAddress {
+			return &val, nil
+		}
+	}
+
+	return nil, fmt.Errorf("validator with address '%s' not found among %d validators",
+		validatorAddress, len(allValidators))
+}
@@ -0,0 +1,167 @@
+// Package config loads the monitor's configuration from config.yaml, HLMON_-prefixed
+// environment variables, and command-line flags (in that ascending order of precedence,
+// per Viper's defaults), and supports reloading config.yaml on the fly without restarting
+// the process.
+package config
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Config is the monitor's full runtime configuration, decoded fresh from Viper on load
+// and on every hot reload.
+type Config struct {
+	APIEndpoints            []string
+	ValidatorAddresses      []string
+	CronInterval            time.Duration
+	DiscordWebhook          string
+	NotifiersConfigPath     string
+	MetricsAddr             string
+	APIDownAlertAfter       time.Duration
+	UnjailImminentThreshold time.Duration
+	StateBackend            string
+	StatePath               string
+	WorkerPoolSize          int
+}
+
+// Validate checks that cfg is complete enough to run the monitor loop. It's called both
+// on initial load and after every hot reload so a bad edit to config.yaml can't take
+// down a running monitor.
+func (c *Config) Validate() error {
+	if len(c.APIEndpoints) == 0 {
+		return fmt.Errorf("at least one API endpoint is required (api-endpoints)")
+	}
+	if len(c.ValidatorAddresses) == 0 {
+		return fmt.Errorf("at least one validator address is required (validator-addresses)")
+	}
+	if c.CronInterval <= 0 {
+		return fmt.Errorf("cron-interval must be positive")
+	}
+	if c.WorkerPoolSize < 1 {
+		return fmt.Errorf("worker-pool-size must be at least 1")
+	}
+	switch c.StateBackend {
+	case "json", "bolt", "boltdb":
+	default:
+		return fmt.Errorf("unknown state-backend %q", c.StateBackend)
+	}
+	return nil
+}
+
+// BindFlags registers every config field as a flag on cmd, so `--help` documents the
+// full configuration surface and flags can override config.yaml/env at the highest
+// precedence.
+func BindFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringSlice("api-endpoints", nil, "Hyperliquid API endpoints, in priority order")
+	flags.StringSlice("validator-addresses", nil, "Validator addresses to monitor")
+	flags.Duration("cron-interval", time.Minute, "Interval between monitor cycles")
+	flags.String("discord-webhook", "", "Fallback Discord webhook used when notifiers-config is absent")
+	flags.String("notifiers-config", "notifiers.yaml", "Path to the notifier routing config")
+	flags.String("metrics-addr", "", "Address to serve Prometheus /metrics on (blank disables)")
+	flags.Duration("api-down-alert-after", 5*time.Minute, "How long all API endpoints must be down before alerting")
+	flags.Duration("unjail-imminent-threshold", 5*time.Minute, "Warn when a jailed validator's unjail time is within this window")
+	flags.String("state-backend", "json", "State store backend: json or bolt")
+	flags.String("state-path", "state.json", "Path to the state store file")
+	flags.Int("worker-pool-size", 5, "Maximum validators checked concurrently")
+}
+
+// Loader owns the Viper instance backing Current, and (once Watch is called) keeps
+// Current up to date as config.yaml changes on disk.
+type Loader struct {
+	v *viper.Viper
+
+	mu      sync.RWMutex
+	current *Config
+}
+
+// NewLoader builds a Loader by reading config.yaml (searched under ./ and
+// /etc/hyperliquid-monitor/), overlaying HLMON_-prefixed environment variables and the
+// flags bound to cmd, and validating the result.
+func NewLoader(cmd *cobra.Command) (*Loader, error) {
+	v := viper.New()
+	v.SetConfigName("config")
+	v.SetConfigType("yaml")
+	v.AddConfigPath(".")
+	v.AddConfigPath("/etc/hyperliquid-monitor/")
+
+	v.SetEnvPrefix("HLMON")
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+
+	if err := v.BindPFlags(cmd.Flags()); err != nil {
+		return nil, fmt.Errorf("error binding flags: %w", err)
+	}
+
+	if err := v.ReadInConfig(); err != nil {
+		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
+			return nil, fmt.Errorf("error reading config.yaml: %w", err)
+		}
+		log.Printf("config: no config.yaml found under ./ or /etc/hyperliquid-monitor/, relying on flags/env/defaults")
+	}
+
+	cfg := decode(v)
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	return &Loader{v: v, current: cfg}, nil
+}
+
+// decode reads every bound key off v into a fresh Config.
+func decode(v *viper.Viper) *Config {
+	return &Config{
+		APIEndpoints:            v.GetStringSlice("api-endpoints"),
+		ValidatorAddresses:      v.GetStringSlice("validator-addresses"),
+		CronInterval:            v.GetDuration("cron-interval"),
+		DiscordWebhook:          v.GetString("discord-webhook"),
+		NotifiersConfigPath:     v.GetString("notifiers-config"),
+		MetricsAddr:             v.GetString("metrics-addr"),
+		APIDownAlertAfter:       v.GetDuration("api-down-alert-after"),
+		UnjailImminentThreshold: v.GetDuration("unjail-imminent-threshold"),
+		StateBackend:            v.GetString("state-backend"),
+		StatePath:               v.GetString("state-path"),
+		WorkerPoolSize:          v.GetInt("worker-pool-size"),
+	}
+}
+
+// Current returns the most recently loaded (and validated) Config. Safe for concurrent
+// use alongside Watch's reload callback.
+func (l *Loader) Current() *Config {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.current
+}
+
+// Watch enables fsnotify-based hot reload of config.yaml, so operators can add/remove
+// validators, change intervals, or repoint notifiers-config without restarting the
+// process. A reload that fails to decode or fails Validate is logged and discarded,
+// leaving the previously applied Config in place; onReload (if non-nil) is only called
+// after a reload has been applied.
+func (l *Loader) Watch(onReload func(*Config)) {
+	l.v.OnConfigChange(func(e fsnotify.Event) {
+		cfg := decode(l.v)
+		if err := cfg.Validate(); err != nil {
+			log.Printf("config: reload from %s failed validation, keeping previous config: %v", e.Name, err)
+			return
+		}
+
+		l.mu.Lock()
+		l.current = cfg
+		l.mu.Unlock()
+
+		log.Printf("config: reloaded from %s", e.Name)
+		if onReload != nil {
+			onReload(cfg)
+		}
+	})
+	l.v.WatchConfig()
+}
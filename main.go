@@ -1,26 +1,23 @@
 package main
 
 import (
-	"bytes"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
-	"os"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/NodeOps-app/Hyperliquid-validator-monitoring/apiclient"
+	"github.com/NodeOps-app/Hyperliquid-validator-monitoring/config"
+	"github.com/NodeOps-app/Hyperliquid-validator-monitoring/statestore"
 )
 
-// Validator represents the structure of validator data from the API response
-type Validator struct {
-	Validator       string `json:"validator"`       // Address of the validator
-	Name            string `json:"name"`            // Name of the validator
-	IsJailed        bool   `json:"isJailed"`        // Indicates if validator is jailed
-	IsActive        bool   `json:"isActive"`        // Indicates if validator is active
-	Commission      string `json:"commission"`      // Commission rate charged by validator
-	UnjailableAfter *int64 `json:"unjailableAfter"` // Timestamp when validator can be unjailed (null if not jailed)
-}
+// Validator is an alias for the apiclient representation of a single validator, kept so
+// the rest of this file doesn't need to change after the API client moved to its own
+// package.
+type Validator = apiclient.Validator
 
 // NotificationBackoff handles exponential backoff for alerts to prevent notification spam
 type NotificationBackoff struct {
@@ -30,17 +27,32 @@ type NotificationBackoff struct {
 
 // ValidatorState tracks validator status between checks for state change detection
 type ValidatorState struct {
-	IsJailed bool // Current jailed status
-	IsActive bool // Current active status
-	FirstRun bool // Indicates first check to prevent false recovery alerts
+	IsJailed       bool   // Current jailed status
+	IsActive       bool   // Current active status
+	Commission     string // Last-seen commission rate
+	UnjailImminent bool   // Whether UnjailableAfter is currently within the warning threshold
+	Missing        bool   // Whether the validator is currently absent from validatorSummaries
+	FirstRun       bool   // Indicates first check to prevent false recovery alerts
 }
 
-// Global variables for tracking notification state and validator status
+// validatorBackoffs bundles the backoff trackers kept per validator address, one per
+// alert condition plus a shared one for recovery messages.
+type validatorBackoffs struct {
+	jailed         *NotificationBackoff
+	inactive       *NotificationBackoff
+	recovery       *NotificationBackoff
+	commission     *NotificationBackoff
+	unjailImminent *NotificationBackoff
+	missing        *NotificationBackoff
+}
+
+// Global state for tracking notification backoff and validator status, keyed by
+// validator address. stateMu guards both maps since runCheck is now invoked
+// concurrently across a worker pool.
 var (
-	jailedBackoff   = &NotificationBackoff{}
-	inactiveBackoff = &NotificationBackoff{}
-	recoveryBackoff = &NotificationBackoff{}
-	validatorState  = &ValidatorState{FirstRun: true}
+	stateMu         sync.Mutex
+	validatorStates = map[string]*ValidatorState{}
+	backoffs        = map[string]*validatorBackoffs{}
 )
 
 // Constants for backoff timing
@@ -49,34 +61,93 @@ const (
 	maxBackoff     = 15 * time.Minute // Maximum backoff interval to prevent excessive delays
 )
 
-// getEnv retrieves environment variable with fallback for CRON_INTERVAL
-func getEnv(key string) string {
-	val := os.Getenv(key)
-	if val == "" && key == "CRON_INTERVAL" {
-		return "1m" // Default check interval
+// stateFor returns the ValidatorState and backoff trackers for address, creating them
+// on first use. Callers must not retain the returned pointers across goroutines without
+// relying on stateMu, but the pointers themselves are safe to mutate directly since each
+// address is only ever processed by one worker at a time.
+func stateFor(address string) (*ValidatorState, *validatorBackoffs) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+
+	state, ok := validatorStates[address]
+	if !ok {
+		state = &ValidatorState{FirstRun: true}
+		validatorStates[address] = state
 	}
-	if val == "" {
-		log.Fatalf("ENV variable %s is required", key)
+
+	bo, ok := backoffs[address]
+	if !ok {
+		bo = &validatorBackoffs{
+			jailed:         &NotificationBackoff{},
+			inactive:       &NotificationBackoff{},
+			recovery:       &NotificationBackoff{},
+			commission:     &NotificationBackoff{},
+			unjailImminent: &NotificationBackoff{},
+			missing:        &NotificationBackoff{},
+		}
+		backoffs[address] = bo
 	}
-	return val
+
+	return state, bo
 }
 
-// notifyDiscord sends alerts to Discord webhook
-func notifyDiscord(webhook, message string) {
-	payload := map[string]string{"content": message}
-	data, _ := json.Marshal(payload)
+// hydrateState pre-populates validatorStates and backoffs from a loaded snapshot so a
+// restart resumes backoff timers and skips the FirstRun recovery guard instead of
+// treating every previously-tracked validator as newly discovered.
+func hydrateState(snapshot *statestore.Snapshot) {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+
+	for address, vs := range snapshot.Validators {
+		validatorStates[address] = &ValidatorState{
+			IsJailed:       vs.IsJailed,
+			IsActive:       vs.IsActive,
+			Commission:     vs.Commission,
+			UnjailImminent: vs.UnjailImminent,
+			Missing:        vs.Missing,
+			FirstRun:       vs.FirstRun,
+		}
+		backoffs[address] = &validatorBackoffs{
+			jailed:         &NotificationBackoff{LastSent: vs.JailedBackoff.LastSent, BackoffFactor: vs.JailedBackoff.BackoffFactor},
+			inactive:       &NotificationBackoff{LastSent: vs.InactiveBackoff.LastSent, BackoffFactor: vs.InactiveBackoff.BackoffFactor},
+			recovery:       &NotificationBackoff{LastSent: vs.RecoveryBackoff.LastSent, BackoffFactor: vs.RecoveryBackoff.BackoffFactor},
+			commission:     &NotificationBackoff{LastSent: vs.CommissionBackoff.LastSent, BackoffFactor: vs.CommissionBackoff.BackoffFactor},
+			unjailImminent: &NotificationBackoff{LastSent: vs.UnjailImminentBackoff.LastSent, BackoffFactor: vs.UnjailImminentBackoff.BackoffFactor},
+			missing:        &NotificationBackoff{LastSent: vs.MissingBackoff.LastSent, BackoffFactor: vs.MissingBackoff.BackoffFactor},
+		}
+	}
+}
 
-	resp, err := http.Post(webhook, "application/json", bytes.NewBuffer(data))
-	if err != nil {
-		log.Printf("Failed to send Discord notification: %v", err)
-		return
+// persistState snapshots validatorStates/backoffs and writes it through store. Called
+// once per cycle, after every check in it has finished, so a restart never loses more
+// than the most recent cycle's state.
+func persistState(store statestore.Store) {
+	stateMu.Lock()
+	snapshot := &statestore.Snapshot{
+		SchemaVersion: statestore.CurrentSchemaVersion,
+		Validators:    make(map[string]statestore.ValidatorState, len(validatorStates)),
 	}
-	defer resp.Body.Close()
+	for address, state := range validatorStates {
+		bo := backoffs[address]
+		snapshot.Validators[address] = statestore.ValidatorState{
+			IsJailed:              state.IsJailed,
+			IsActive:              state.IsActive,
+			Commission:            state.Commission,
+			UnjailImminent:        state.UnjailImminent,
+			Missing:               state.Missing,
+			FirstRun:              state.FirstRun,
+			JailedBackoff:         statestore.Backoff{LastSent: bo.jailed.LastSent, BackoffFactor: bo.jailed.BackoffFactor},
+			InactiveBackoff:       statestore.Backoff{LastSent: bo.inactive.LastSent, BackoffFactor: bo.inactive.BackoffFactor},
+			RecoveryBackoff:       statestore.Backoff{LastSent: bo.recovery.LastSent, BackoffFactor: bo.recovery.BackoffFactor},
+			CommissionBackoff:     statestore.Backoff{LastSent: bo.commission.LastSent, BackoffFactor: bo.commission.BackoffFactor},
+			UnjailImminentBackoff: statestore.Backoff{LastSent: bo.unjailImminent.LastSent, BackoffFactor: bo.unjailImminent.BackoffFactor},
+			MissingBackoff:        statestore.Backoff{LastSent: bo.missing.LastSent, BackoffFactor: bo.missing.BackoffFactor},
+		}
+	}
+	stateMu.Unlock()
 
-	if resp.StatusCode != 204 && resp.StatusCode != 200 {
-		log.Printf("Discord webhook returned status: %s", resp.Status)
-	} else {
-		log.Println("Sent Discord alert:", message)
+	if err := store.Save(snapshot); err != nil {
+		log.Printf("Error persisting state: %v", err)
 	}
 }
 
@@ -117,17 +188,60 @@ func resetBackoff(state *NotificationBackoff) {
 	state.LastSent = time.Time{}
 }
 
-// runCheck performs a single validation check cycle
-func runCheck(apiEndpoint, validatorAddress, discordWebhook string) {
+// isUnjailImminent reports whether validator is jailed with an UnjailableAfter timestamp
+// falling within threshold of now, so operators can be warned before it happens.
+func isUnjailImminent(validator *Validator, threshold time.Duration) bool {
+	if !validator.IsJailed || validator.UnjailableAfter == nil {
+		return false
+	}
+	unjailTime := time.Unix(*validator.UnjailableAfter/1000, 0)
+	remaining := time.Until(unjailTime)
+	return remaining > 0 && remaining <= threshold
+}
+
+// handleMissingValidator alerts when a tracked validator address disappears entirely
+// from validatorSummaries, which today would otherwise just be a logged lookup error.
+func handleMissingValidator(validatorAddress string, state *ValidatorState, bo *validatorBackoffs, router *NotifierRouter) {
+	identifier := formatValidatorIdentifier("", validatorAddress)
+
+	if !state.FirstRun && shouldNotify(bo.missing) {
+		message := fmt.Sprintf("🚨 Validator %s has DISAPPEARED from validatorSummaries", identifier)
+		router.Dispatch(Alert{Type: AlertValidatorMissing, ValidatorAddress: validatorAddress, Message: message})
+		log.Printf("Alert: %s", message)
+		updateBackoff(bo.missing)
+	}
+
+	state.Missing = true
+	state.FirstRun = false
+}
+
+// runCheck evaluates a single validator's freshly fetched data against its previously
+// recorded state and fires any jailed/inactive/commission-change/unjail-imminent/missing/
+// recovery alerts. validator is nil when the
+// address could not be found in the shared validatorSummaries fetch for this cycle.
+func runCheck(validatorAddress string, validator *Validator, fetchErr error, router *NotifierRouter, unjailImminentThreshold time.Duration) {
 	startTime := time.Now()
-	log.Printf("Fetching validator status for address: %s", validatorAddress)
 
-	validator, err := fetchValidatorData(apiEndpoint, validatorAddress)
-	if err != nil {
-		log.Printf("Error fetching validator data: %v", err)
+	state, bo := stateFor(validatorAddress)
+
+	if fetchErr != nil {
+		log.Printf("Error fetching validator data for %s: %v", validatorAddress, fetchErr)
+		handleMissingValidator(validatorAddress, state, bo, router)
 		return
 	}
 
+	// Recovered from having disappeared out of validatorSummaries entirely.
+	if state.Missing {
+		identifier := formatValidatorIdentifier(validator.Name, validatorAddress)
+		message := fmt.Sprintf("✅ Validator %s has REAPPEARED in validatorSummaries", identifier)
+		router.Dispatch(Alert{Type: AlertRecovery, RecoversType: AlertValidatorMissing, Resolved: true,
+			ValidatorAddress: validatorAddress, ValidatorName: validator.Name, Message: message})
+		log.Printf("Recovery detected: %s", message)
+		resetBackoff(bo.recovery)
+		resetBackoff(bo.missing)
+		state.Missing = false
+	}
+
 	validatorName := validator.Name
 	if validatorName == "" {
 		validatorName = validatorAddress[:10] + "..." // Use truncated address if name is not available
@@ -139,33 +253,73 @@ func runCheck(apiEndpoint, validatorAddress, discordWebhook string) {
 	log.Printf("Validator %s status: active=%v, jailed=%v, commission=%s",
 		validatorIdentifier, validator.IsActive, validator.IsJailed, validator.Commission)
 
+	recordValidatorMetrics(validatorAddress, validatorName, validator)
+
+	unjailImminent := isUnjailImminent(validator, unjailImminentThreshold)
+
 	// Recovery detection - only after first run completed
-	if !validatorState.FirstRun {
+	if !state.FirstRun {
 		// Check for jailed -> not jailed transition
-		if validatorState.IsJailed && !validator.IsJailed {
+		if state.IsJailed && !validator.IsJailed {
 			message := fmt.Sprintf("✅ Validator %s has RECOVERED from jailed state", validatorIdentifier)
-			notifyDiscord(discordWebhook, message)
+			router.Dispatch(Alert{Type: AlertRecovery, RecoversType: AlertJailed, Resolved: true,
+				ValidatorAddress: validatorAddress, ValidatorName: validatorName, Message: message})
 			log.Printf("Recovery detected: %s", message)
-			resetBackoff(recoveryBackoff)
+			resetBackoff(bo.recovery)
 		}
 
 		// Check for inactive -> active transition
-		if !validatorState.IsActive && validator.IsActive {
+		if !state.IsActive && validator.IsActive {
 			message := fmt.Sprintf("✅ Validator %s is now ACTIVE", validatorIdentifier)
-			notifyDiscord(discordWebhook, message)
+			router.Dispatch(Alert{Type: AlertRecovery, RecoversType: AlertInactive, Resolved: true,
+				ValidatorAddress: validatorAddress, ValidatorName: validatorName, Message: message})
+			log.Printf("Recovery detected: %s", message)
+			resetBackoff(bo.recovery)
+		}
+
+		// Check for unjail-imminent window closing (either unjailed already, or the
+		// window simply passed without us seeing the unjail happen)
+		if state.UnjailImminent && !unjailImminent {
+			message := fmt.Sprintf("✅ Validator %s unjail warning window has closed", validatorIdentifier)
+			router.Dispatch(Alert{Type: AlertRecovery, RecoversType: AlertUnjailImminent, Resolved: true,
+				ValidatorAddress: validatorAddress, ValidatorName: validatorName, Message: message})
 			log.Printf("Recovery detected: %s", message)
-			resetBackoff(recoveryBackoff)
+			resetBackoff(bo.recovery)
+		}
+
+		// Commission changes are a one-off event rather than a sustained condition, so
+		// there's no matching recovery message - just the change itself, backed off to
+		// avoid spam if the API briefly flaps between two values. state.Commission is only
+		// advanced once the change is actually dispatched: if it were advanced
+		// unconditionally, a change suppressed by backoff would be silently forgotten
+		// (the next cycle would compare against the new value and see no change at all)
+		// instead of being reported as soon as the backoff window clears.
+		if validator.Commission != state.Commission {
+			if shouldNotify(bo.commission) {
+				message := fmt.Sprintf("⚠️ Validator %s commission changed: %s → %s",
+					validatorIdentifier, state.Commission, validator.Commission)
+				router.Dispatch(Alert{Type: AlertCommissionChange, ValidatorAddress: validatorAddress, ValidatorName: validatorName, Message: message})
+				log.Printf("Alert: %s", message)
+				updateBackoff(bo.commission)
+				state.Commission = validator.Commission
+			}
+		} else {
+			resetBackoff(bo.commission)
 		}
+	} else {
+		// First run: nothing to compare against yet, so just record the starting value.
+		state.Commission = validator.Commission
 	}
 
 	// Update state for next comparison
-	validatorState.IsJailed = validator.IsJailed
-	validatorState.IsActive = validator.IsActive
-	validatorState.FirstRun = false
+	state.IsJailed = validator.IsJailed
+	state.IsActive = validator.IsActive
+	state.UnjailImminent = unjailImminent
+	state.FirstRun = false
 
 	// Handle jailed status alerts with backoff
 	if validator.IsJailed {
-		if shouldNotify(jailedBackoff) {
+		if shouldNotify(bo.jailed) {
 			unjailMsg := ""
 			if validator.UnjailableAfter != nil {
 				unjailTime := time.Unix(*validator.UnjailableAfter/1000, 0)
@@ -173,102 +327,235 @@ func runCheck(apiEndpoint, validatorAddress, discordWebhook string) {
 			}
 
 			message := fmt.Sprintf("🚨 Validator %s is JAILED%s", validatorIdentifier, unjailMsg)
-			notifyDiscord(discordWebhook, message)
+			router.Dispatch(Alert{Type: AlertJailed, ValidatorAddress: validatorAddress, ValidatorName: validatorName, Message: message})
 			log.Printf("Alert: %s", message)
-			updateBackoff(jailedBackoff)
+			updateBackoff(bo.jailed)
 		}
 	} else {
-		resetBackoff(jailedBackoff)
+		resetBackoff(bo.jailed)
 	}
 
 	// Handle inactive status alerts with backoff
 	if !validator.IsActive {
-		if shouldNotify(inactiveBackoff) {
+		if shouldNotify(bo.inactive) {
 			message := fmt.Sprintf("🚨 Validator %s is INACTIVE", validatorIdentifier)
-			notifyDiscord(discordWebhook, message)
+			router.Dispatch(Alert{Type: AlertInactive, ValidatorAddress: validatorAddress, ValidatorName: validatorName, Message: message})
 			log.Printf("Alert: %s", message)
-			updateBackoff(inactiveBackoff)
+			updateBackoff(bo.inactive)
 		}
 	} else {
-		resetBackoff(inactiveBackoff)
+		resetBackoff(bo.inactive)
+	}
+
+	// Warn operators the unjail window is approaching so they can prepare
+	if unjailImminent {
+		if shouldNotify(bo.unjailImminent) {
+			unjailTime := time.Unix(*validator.UnjailableAfter/1000, 0)
+			message := fmt.Sprintf("⏰ Validator %s can be unjailed in %s (at %s)",
+				validatorIdentifier, time.Until(unjailTime).Round(time.Second), unjailTime.Format(time.RFC3339))
+			router.Dispatch(Alert{Type: AlertUnjailImminent, ValidatorAddress: validatorAddress, ValidatorName: validatorName, Message: message})
+			log.Printf("Alert: %s", message)
+			updateBackoff(bo.unjailImminent)
+		}
+	} else {
+		resetBackoff(bo.unjailImminent)
 	}
 
 	elapsed := time.Since(startTime)
 	log.Printf("Validator %s monitor check complete (took %dms)", validatorIdentifier, elapsed.Milliseconds())
 }
 
-// fetchValidatorData retrieves validator data from the API and finds the requested validator
-// Case-insensitive comparison is used for addresses to prevent configuration errors
-func fetchValidatorData(apiEndpoint string, validatorAddress string) (*Validator, error) {
-	startTime := time.Now()
+// monitoringDegradedBackoff and monitoringDegradedActive track the distinct "monitoring
+// degraded" alert fired when every API endpoint has been unreachable for longer than
+// apiDownAlertAfter, so operators aren't blind during upstream outages.
+var (
+	monitoringDegradedBackoff = &NotificationBackoff{}
+	monitoringDegradedActive  bool
+)
 
-	payload := []byte(`{"type":"validatorSummaries"}`)
-	req, err := http.NewRequest("POST", apiEndpoint, bytes.NewBuffer(payload))
-	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+// checkMonitoringDegraded fires (with its own backoff) once the API client reports every
+// endpoint has been down continuously for longer than apiDownAlertAfter.
+func checkMonitoringDegraded(client *apiclient.Client, router *NotifierRouter, apiDownAlertAfter time.Duration) {
+	since, down := client.DownSince()
+	if !down || time.Since(since) < apiDownAlertAfter {
+		return
 	}
-	req.Header.Set("Content-Type", "application/json")
-
-	client := http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("API request failed: %w", err)
+	if !shouldNotify(monitoringDegradedBackoff) {
+		return
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API returned non-200 status: %s", resp.Status)
+	message := fmt.Sprintf("🔥 Monitoring DEGRADED: all API endpoints unreachable since %s", since.Format(time.RFC3339))
+	router.Dispatch(Alert{Type: AlertMonitoringDegraded, Message: message})
+	log.Printf("Alert: %s", message)
+	updateBackoff(monitoringDegradedBackoff)
+	monitoringDegradedActive = true
+}
+
+// recordMonitoringRecovered clears the degraded alert once a fetch succeeds again.
+func recordMonitoringRecovered(router *NotifierRouter) {
+	if !monitoringDegradedActive {
+		return
 	}
 
-	var allValidators []Validator
-	body, err := io.ReadAll(resp.Body)
+	message := "✅ Monitoring RECOVERED: API reachable again"
+	router.Dispatch(Alert{Type: AlertRecovery, RecoversType: AlertMonitoringDegraded, Resolved: true, Message: message})
+	log.Printf("Recovery detected: %s", message)
+	resetBackoff(monitoringDegradedBackoff)
+	monitoringDegradedActive = false
+}
+
+// runCycle fetches validatorSummaries once through the resilient apiclient and fans the
+// per-validator checks out across a bounded worker pool so that monitoring many
+// validators doesn't serialize on notification round-trips or grow unboundedly with the
+// validator count. State is persisted exactly once per cycle, after every worker has
+// finished mutating its address's ValidatorState/backoffs, rather than once per check:
+// persisting per-check let concurrent workers race on the package-level state maps (only
+// the map lookup in stateFor is guarded, not the per-address field writes persistState
+// reads) and had every worker hammer JSONFileStore's single "path.tmp" file at once,
+// risking a torn write getting renamed into place.
+func runCycle(client *apiclient.Client, addresses []string, router *NotifierRouter, store statestore.Store, poolSize int, apiDownAlertAfter, unjailImminentThreshold time.Duration) {
+	startTime := time.Now()
+	allValidators, err := client.FetchValidatorSummaries()
+	apiFetchDurationSeconds.Observe(time.Since(startTime).Seconds())
 	if err != nil {
-		return nil, fmt.Errorf("error reading response body: %w", err)
+		apiErrorsTotal.Inc()
+		log.Printf("Error fetching validatorSummaries: %v", err)
+		checkMonitoringDegraded(client, router, apiDownAlertAfter)
+		return
+	}
+	recordMonitoringRecovered(router)
+
+	jobs := make(chan string)
+	var wg sync.WaitGroup
+
+	for i := 0; i < poolSize; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for address := range jobs {
+				validator, lookupErr := apiclient.FindValidator(allValidators, address)
+				runCheck(address, validator, lookupErr, router, unjailImminentThreshold)
+			}
+		}()
 	}
 
-	if err := json.Unmarshal(body, &allValidators); err != nil {
-		return nil, fmt.Errorf("error parsing API response: %w", err)
+	for _, address := range addresses {
+		jobs <- address
 	}
+	close(jobs)
 
-	log.Printf("API returned data for %d validators (took %dms)", len(allValidators), time.Since(startTime).Milliseconds())
+	wg.Wait()
+	persistState(store)
+	recordCycleComplete()
+}
 
-	lowercaseInputAddress := strings.ToLower(validatorAddress)
-	for _, val := range allValidators {
-		if strings.ToLower(val.Validator) == lowercaseInputAddress {
-			return &val, nil
-		}
+// routerHolder guards the active NotifierRouter so a config hot reload can swap in a
+// freshly built router without racing the in-flight monitor cycle reading it.
+type routerHolder struct {
+	mu     sync.RWMutex
+	router *NotifierRouter
+}
+
+func (h *routerHolder) get() *NotifierRouter {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.router
+}
+
+func (h *routerHolder) set(router *NotifierRouter) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.router = router
+}
+
+// buildRouter loads notifiers-config, falling back to a single Discord sink wired to
+// discord-webhook when no usable notifiers config is present.
+func buildRouter(cfg *config.Config) *NotifierRouter {
+	router, err := loadNotifierRouter(cfg.NotifiersConfigPath)
+	if err != nil {
+		log.Printf("No usable notifiers config at %s (%v), falling back to discord-webhook", cfg.NotifiersConfigPath, err)
+		router = defaultNotifierRouter(cfg.DiscordWebhook)
 	}
+	return router
+}
+
+// rootCmd is the monitor's single entry point. Its flags double as the highest-precedence
+// layer of the config package's file+env+flag configuration.
+var rootCmd = &cobra.Command{
+	Use:   "hyperliquid-validator-monitor",
+	Short: "Monitors Hyperliquid validators and sends alerts",
+	RunE:  run,
+}
 
-	return nil, fmt.Errorf("validator with address '%s' not found among %d validators",
-		validatorAddress, len(allValidators))
+func init() {
+	config.BindFlags(rootCmd)
 }
 
-// main initializes the application and starts the monitoring loop
 func main() {
 	log.SetFlags(log.LstdFlags | log.Lmicroseconds | log.LUTC)
+	if err := rootCmd.Execute(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run initializes the application from config.yaml/HLMON_ env vars/flags and starts the
+// monitoring loop, re-reading the config at the top of every cycle so a hot reload takes
+// effect on the next check rather than requiring a restart.
+func run(cmd *cobra.Command, args []string) error {
 	log.Printf("Validator Monitor starting up...")
 
-	apiEndpoint := getEnv("API_ENDPOINT")
-	validatorAddress := getEnv("VALIDATOR_ADDRESS")
-	discordWebhook := getEnv("DISCORD_WEBHOOK")
-	cronInterval := getEnv("CRON_INTERVAL")
+	loader, err := config.NewLoader(cmd)
+	if err != nil {
+		return err
+	}
+	cfg := loader.Current()
 
-	duration, err := time.ParseDuration(cronInterval)
+	client := apiclient.NewClient(cfg.APIEndpoints)
+
+	store, err := statestore.NewStore(cfg.StateBackend, cfg.StatePath)
 	if err != nil {
-		log.Fatalf("Invalid CRON_INTERVAL '%s': %v", cronInterval, err)
+		return fmt.Errorf("error initializing state store: %w", err)
 	}
+	defer store.Close()
+
+	snapshot, err := store.Load()
+	if err != nil {
+		return fmt.Errorf("error loading persisted state: %w", err)
+	}
+	hydrateState(snapshot)
+	log.Printf("Loaded persisted state for %d validator(s)", len(snapshot.Validators))
+
+	routers := &routerHolder{}
+	routers.set(buildRouter(cfg))
 
-	log.Printf("Configuration loaded - API: %s, Address: %s, Interval: %s",
-		apiEndpoint, validatorAddress, duration)
+	loader.Watch(func(newCfg *config.Config) {
+		routers.set(buildRouter(newCfg))
+		routers.get().Dispatch(Alert{Type: AlertInfo, Message: "♻️ Configuration reloaded"})
+	})
+
+	startMetricsServer(cfg.MetricsAddr)
+
+	log.Printf("Configuration loaded - API endpoints: %s, Addresses: %s, Interval: %s, Workers: %d",
+		strings.Join(cfg.APIEndpoints, ","), strings.Join(cfg.ValidatorAddresses, ","), cfg.CronInterval, cfg.WorkerPoolSize)
 
 	// Initial notification to confirm monitoring has started
-	notifyDiscord(discordWebhook, fmt.Sprintf("🔄 Validator monitoring started for %s (checking every %s)",
-		validatorAddress, duration))
+	routers.get().Dispatch(Alert{Type: AlertInfo, Message: fmt.Sprintf("🔄 Validator monitoring started for %d validator(s) (checking every %s)",
+		len(cfg.ValidatorAddresses), cfg.CronInterval)})
 
-	// Main monitoring loop
+	// Main monitoring loop. cfg is re-read from the loader every cycle so added/removed
+	// validators and interval/pool-size changes take effect without a restart; the API
+	// endpoint list is only applied at startup since the apiclient.Client owns its own
+	// per-endpoint circuit breaker state that a hot-swap would otherwise discard.
 	for {
-		runCheck(apiEndpoint, validatorAddress, discordWebhook)
-		log.Printf("Sleeping for %s before next check", duration)
-		time.Sleep(duration)
+		cfg = loader.Current()
+
+		poolSize := cfg.WorkerPoolSize
+		if poolSize > len(cfg.ValidatorAddresses) {
+			poolSize = len(cfg.ValidatorAddresses)
+		}
+
+		runCycle(client, cfg.ValidatorAddresses, routers.get(), store, poolSize, cfg.APIDownAlertAfter, cfg.UnjailImminentThreshold)
+		log.Printf("Sleeping for %s before next check", cfg.CronInterval)
+		time.Sleep(cfg.CronInterval)
 	}
 }
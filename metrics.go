@@ -0,0 +1,89 @@
+package main
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Prometheus metrics exposed on metrics-addr. Names follow the hyperliquid_ prefix so
+// they sit alongside any other Hyperliquid-related exporters on the same scrape target.
+var (
+	validatorJailedGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hyperliquid_validator_jailed",
+		Help: "1 if the validator is currently jailed, 0 otherwise",
+	}, []string{"address", "name"})
+
+	validatorActiveGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hyperliquid_validator_active",
+		Help: "1 if the validator is currently active, 0 otherwise",
+	}, []string{"address", "name"})
+
+	validatorCommissionGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "hyperliquid_validator_commission",
+		Help: "Current commission rate charged by the validator",
+	}, []string{"address", "name"})
+
+	apiFetchDurationSeconds = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name: "hyperliquid_api_fetch_duration_seconds",
+		Help: "Duration of validatorSummaries API fetches",
+	})
+
+	apiErrorsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "hyperliquid_api_errors_total",
+		Help: "Total number of failed validatorSummaries API fetches",
+	})
+
+	lastCheckTimestamp = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "hyperliquid_last_check_timestamp",
+		Help: "Unix timestamp of the last completed monitor cycle",
+	})
+)
+
+// startMetricsServer serves /metrics on addr in the background. A blank addr disables
+// the metrics endpoint entirely.
+func startMetricsServer(addr string) {
+	if addr == "" {
+		log.Printf("metrics-addr not set, Prometheus metrics endpoint disabled")
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	go func() {
+		log.Printf("Metrics server listening on %s", addr)
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			log.Printf("Metrics server error: %v", err)
+		}
+	}()
+}
+
+// recordValidatorMetrics updates the per-validator gauges after a successful check.
+func recordValidatorMetrics(address, name string, validator *Validator) {
+	labels := prometheus.Labels{"address": address, "name": name}
+
+	validatorJailedGauge.With(labels).Set(boolToFloat(validator.IsJailed))
+	validatorActiveGauge.With(labels).Set(boolToFloat(validator.IsActive))
+
+	if commission, err := strconv.ParseFloat(validator.Commission, 64); err == nil {
+		validatorCommissionGauge.With(labels).Set(commission)
+	}
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// recordCycleComplete marks the timestamp of the most recently completed monitor cycle.
+func recordCycleComplete() {
+	lastCheckTimestamp.Set(float64(time.Now().Unix()))
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// AlertType identifies the kind of condition a notification is about, used both for
+// notifiers.yaml routing and for PagerDuty incident dedup keys.
+type AlertType string
+
+const (
+	AlertJailed             AlertType = "jailed"
+	AlertInactive           AlertType = "inactive"
+	AlertRecovery           AlertType = "recovery"
+	AlertCommissionChange   AlertType = "commission_change"
+	AlertMonitoringDegraded AlertType = "monitoring_degraded"
+	AlertUnjailImminent     AlertType = "unjail_imminent"
+	AlertValidatorMissing   AlertType = "validator_missing"
+
+	// AlertInfo is for operational/lifecycle notices (monitor startup, config reload)
+	// that aren't tied to any single validator's condition. It's routed independently of
+	// AlertRecovery so a notifiers.yaml that sends "recovery" to an incident-opening sink
+	// like PagerDuty doesn't open a spurious incident for a routine config reload.
+	AlertInfo AlertType = "info"
+)
+
+// Alert is the sink-agnostic representation of a single notification. Notifier
+// implementations translate it into their own wire format.
+type Alert struct {
+	Type             AlertType // routing key, e.g. AlertJailed
+	ValidatorAddress string
+	ValidatorName    string
+	Message          string    // human-readable message, already formatted (emoji, identifier, etc.)
+	Resolved         bool      // true when this alert clears a previously firing condition
+	RecoversType     AlertType // when Resolved is true, which AlertType's incident this resolves (defaults to Type)
+}
+
+// dedupKey returns the validator+condition key PagerDuty uses to match create/resolve
+// events to the same incident.
+func (a Alert) dedupKey() string {
+	t := a.Type
+	if a.Resolved && a.RecoversType != "" {
+		t = a.RecoversType
+	}
+	return fmt.Sprintf("%s:%s", a.ValidatorAddress, t)
+}
+
+// Notifier is the interface every notification sink implements. Send should return a
+// non-nil error for failures worth retrying (network errors, non-2xx responses); the
+// dispatcher applies its own retry/backoff on top.
+type Notifier interface {
+	Name() string
+	Send(alert Alert) error
+}
+
+// notifierRetryAttempts and notifierRetryBaseDelay bound the per-sink retry performed by
+// sendWithRetry. Kept small since alerts are time-sensitive and block the check loop.
+const (
+	notifierRetryAttempts  = 3
+	notifierRetryBaseDelay = 2 * time.Second
+)
+
+// sendWithRetry calls n.Send, retrying failures with a delay that grows linearly with
+// the attempt number (notifierRetryBaseDelay, then twice that).
+// Errors from every attempt are logged with the sink name so operators can tell which
+// notifier is unhealthy.
+func sendWithRetry(n Notifier, alert Alert) {
+	var err error
+	for attempt := 1; attempt <= notifierRetryAttempts; attempt++ {
+		if err = n.Send(alert); err == nil {
+			return
+		}
+		log.Printf("Notifier %s: attempt %d/%d failed: %v", n.Name(), attempt, notifierRetryAttempts, err)
+		if attempt < notifierRetryAttempts {
+			time.Sleep(notifierRetryBaseDelay * time.Duration(attempt))
+		}
+	}
+	log.Printf("Notifier %s: giving up on alert for %s after %d attempts: %v", n.Name(), alert.ValidatorAddress, notifierRetryAttempts, err)
+}
+
+// DiscordNotifier sends alerts to a Discord webhook.
+type DiscordNotifier struct {
+	Webhook string
+}
+
+func (d *DiscordNotifier) Name() string { return "discord" }
+
+func (d *DiscordNotifier) Send(alert Alert) error {
+	payload := map[string]string{"content": alert.Message}
+	data, _ := json.Marshal(payload)
+
+	resp, err := http.Post(d.Webhook, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("discord request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 204 && resp.StatusCode != 200 {
+		return fmt.Errorf("discord webhook returned status: %s", resp.Status)
+	}
+	log.Println("Sent Discord alert:", alert.Message)
+	return nil
+}
+
+// SlackNotifier sends alerts to a Slack-compatible incoming webhook.
+type SlackNotifier struct {
+	Webhook   string
+	Channel   string
+	Username  string
+	IconEmoji string
+}
+
+func (s *SlackNotifier) Name() string { return "slack" }
+
+func (s *SlackNotifier) Send(alert Alert) error {
+	payload := map[string]string{
+		"text": alert.Message,
+	}
+	if s.Channel != "" {
+		payload["channel"] = s.Channel
+	}
+	if s.Username != "" {
+		payload["username"] = s.Username
+	}
+	if s.IconEmoji != "" {
+		payload["icon_emoji"] = s.IconEmoji
+	}
+	data, _ := json.Marshal(payload)
+
+	resp, err := http.Post(s.Webhook, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("slack request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack webhook returned status: %s", resp.Status)
+	}
+	log.Println("Sent Slack alert:", alert.Message)
+	return nil
+}
+
+// PagerDutyNotifier sends alerts to the PagerDuty Events API v2, creating an incident on
+// the first alert for a validator+condition and resolving it automatically on recovery.
+type PagerDutyNotifier struct {
+	RoutingKey string
+}
+
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+func (p *PagerDutyNotifier) Name() string { return "pagerduty" }
+
+func (p *PagerDutyNotifier) Send(alert Alert) error {
+	action := "trigger"
+	if alert.Resolved {
+		action = "resolve"
+	}
+
+	body := map[string]interface{}{
+		"routing_key":  p.RoutingKey,
+		"event_action": action,
+		"dedup_key":    alert.dedupKey(),
+		"payload": map[string]interface{}{
+			"summary":  alert.Message,
+			"source":   alert.ValidatorAddress,
+			"severity": "critical",
+		},
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("error marshaling pagerduty payload: %w", err)
+	}
+
+	resp, err := http.Post(pagerDutyEventsURL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("pagerduty request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusAccepted {
+		return fmt.Errorf("pagerduty returned status: %s", resp.Status)
+	}
+	log.Printf("Sent PagerDuty %s for %s", action, alert.dedupKey())
+	return nil
+}
+
+// TelegramNotifier sends alerts via the Telegram Bot API.
+type TelegramNotifier struct {
+	BotToken string
+	ChatID   string
+}
+
+func (t *TelegramNotifier) Name() string { return "telegram" }
+
+func (t *TelegramNotifier) Send(alert Alert) error {
+	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", t.BotToken)
+	payload := map[string]string{
+		"chat_id": t.ChatID,
+		"text":    alert.Message,
+	}
+	data, _ := json.Marshal(payload)
+
+	resp, err := http.Post(url, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("telegram request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("telegram API returned status: %s", resp.Status)
+	}
+	log.Println("Sent Telegram alert:", alert.Message)
+	return nil
+}
+
+// AlertmanagerNotifier pushes alerts to an Alertmanager /api/v2/alerts endpoint, using
+// the same jailed/inactive/recovery transitions as every other sink to set startsAt and
+// endsAt so Alertmanager can auto-resolve the alert on recovery.
+type AlertmanagerNotifier struct {
+	URL string // e.g. http://alertmanager:9093/api/v2/alerts
+}
+
+func (a *AlertmanagerNotifier) Name() string { return "alertmanager" }
+
+// alertName returns the condition this alert is about, so a recovery alert reports the
+// name of the incident it resolves rather than "recovery" itself.
+func (a Alert) alertName() string {
+	if a.Resolved && a.RecoversType != "" {
+		return string(a.RecoversType)
+	}
+	return string(a.Type)
+}
+
+func (a *AlertmanagerNotifier) Send(alert Alert) error {
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	payload := map[string]interface{}{
+		"labels": map[string]string{
+			"alertname": fmt.Sprintf("hyperliquid_validator_%s", alert.alertName()),
+			"address":   alert.ValidatorAddress,
+			"name":      alert.ValidatorName,
+			"severity":  "critical",
+		},
+		"annotations": map[string]string{"summary": alert.Message},
+		"startsAt":    now,
+	}
+	if alert.Resolved {
+		payload["endsAt"] = now
+	}
+
+	data, err := json.Marshal([]interface{}{payload})
+	if err != nil {
+		return fmt.Errorf("error marshaling alertmanager payload: %w", err)
+	}
+
+	resp, err := http.Post(a.URL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("alertmanager request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("alertmanager returned status: %s", resp.Status)
+	}
+	log.Printf("Sent Alertmanager alert for %s", alert.dedupKey())
+	return nil
+}
+
+// WebhookNotifier posts an alert to an arbitrary HTTP endpoint using a configurable
+// template. The template may reference {{.Message}}, {{.ValidatorAddress}},
+// {{.ValidatorName}}, and {{.Type}}; when empty, a generic JSON body is sent instead.
+type WebhookNotifier struct {
+	URL      string
+	Template string
+}
+
+func (w *WebhookNotifier) Name() string { return "webhook" }
+
+func (w *WebhookNotifier) Send(alert Alert) error {
+	var data []byte
+	if w.Template != "" {
+		rendered := w.Template
+		rendered = strings.ReplaceAll(rendered, "{{.Message}}", alert.Message)
+		rendered = strings.ReplaceAll(rendered, "{{.ValidatorAddress}}", alert.ValidatorAddress)
+		rendered = strings.ReplaceAll(rendered, "{{.ValidatorName}}", alert.ValidatorName)
+		rendered = strings.ReplaceAll(rendered, "{{.Type}}", string(alert.Type))
+		data = []byte(rendered)
+	} else {
+		payload := map[string]string{
+			"type":              string(alert.Type),
+			"validator_address": alert.ValidatorAddress,
+			"validator_name":    alert.ValidatorName,
+			"message":           alert.Message,
+		}
+		data, _ = json.Marshal(payload)
+	}
+
+	resp, err := http.Post(w.URL, "application/json", bytes.NewBuffer(data))
+	if err != nil {
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status: %s", resp.Status)
+	}
+	log.Println("Sent generic webhook alert:", alert.Message)
+	return nil
+}
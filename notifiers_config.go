@@ -0,0 +1,149 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// sinkConfig is the raw YAML shape of a single entry under `sinks:` in notifiers.yaml.
+// Which fields are required depends on Type.
+type sinkConfig struct {
+	Type       string `yaml:"type"` // discord, slack, pagerduty, telegram, webhook, alertmanager
+	Webhook    string `yaml:"webhook"`
+	Channel    string `yaml:"channel"`
+	Username   string `yaml:"username"`
+	IconEmoji  string `yaml:"icon_emoji"`
+	RoutingKey string `yaml:"routing_key"`
+	BotToken   string `yaml:"bot_token"`
+	ChatID     string `yaml:"chat_id"`
+	URL        string `yaml:"url"`
+	Template   string `yaml:"template"`
+}
+
+// notifiersConfig is the root shape of notifiers.yaml: named sinks, and a routing table
+// mapping alert types to one or more of those sink names.
+type notifiersConfig struct {
+	Sinks  map[string]sinkConfig `yaml:"sinks"`
+	Routes map[string][]string   `yaml:"routes"`
+}
+
+// NotifierRouter dispatches alerts to the set of notifiers configured for their type.
+type NotifierRouter struct {
+	sinks  map[string]Notifier
+	routes map[AlertType][]string
+}
+
+// Dispatch sends alert through every sink routed for alert.Type, retrying each
+// independently. Unrouted alert types are logged and dropped.
+func (r *NotifierRouter) Dispatch(alert Alert) {
+	sinkNames, ok := r.routes[alert.Type]
+	if !ok || len(sinkNames) == 0 {
+		log.Printf("No notifier sinks configured for alert type %q, dropping alert for %s", alert.Type, alert.ValidatorAddress)
+		return
+	}
+
+	for _, name := range sinkNames {
+		notifier, ok := r.sinks[name]
+		if !ok {
+			log.Printf("Route for %q references unknown sink %q", alert.Type, name)
+			continue
+		}
+		sendWithRetry(notifier, alert)
+	}
+}
+
+// buildNotifier constructs the Notifier implementation for a single sink config entry.
+func buildNotifier(name string, cfg sinkConfig) (Notifier, error) {
+	switch cfg.Type {
+	case "discord":
+		if cfg.Webhook == "" {
+			return nil, fmt.Errorf("sink %q: discord requires webhook", name)
+		}
+		return &DiscordNotifier{Webhook: cfg.Webhook}, nil
+	case "slack":
+		if cfg.Webhook == "" {
+			return nil, fmt.Errorf("sink %q: slack requires webhook", name)
+		}
+		return &SlackNotifier{Webhook: cfg.Webhook, Channel: cfg.Channel, Username: cfg.Username, IconEmoji: cfg.IconEmoji}, nil
+	case "pagerduty":
+		if cfg.RoutingKey == "" {
+			return nil, fmt.Errorf("sink %q: pagerduty requires routing_key", name)
+		}
+		return &PagerDutyNotifier{RoutingKey: cfg.RoutingKey}, nil
+	case "telegram":
+		if cfg.BotToken == "" || cfg.ChatID == "" {
+			return nil, fmt.Errorf("sink %q: telegram requires bot_token and chat_id", name)
+		}
+		return &TelegramNotifier{BotToken: cfg.BotToken, ChatID: cfg.ChatID}, nil
+	case "webhook":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("sink %q: webhook requires url", name)
+		}
+		return &WebhookNotifier{URL: cfg.URL, Template: cfg.Template}, nil
+	case "alertmanager":
+		if cfg.URL == "" {
+			return nil, fmt.Errorf("sink %q: alertmanager requires url", name)
+		}
+		return &AlertmanagerNotifier{URL: cfg.URL}, nil
+	default:
+		return nil, fmt.Errorf("sink %q: unknown type %q", name, cfg.Type)
+	}
+}
+
+// loadNotifierRouter reads and validates notifiers.yaml at path, building a router with
+// every configured sink and its routing table.
+func loadNotifierRouter(path string) (*NotifierRouter, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	var cfg notifiersConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	sinks := make(map[string]Notifier, len(cfg.Sinks))
+	for name, sinkCfg := range cfg.Sinks {
+		notifier, err := buildNotifier(name, sinkCfg)
+		if err != nil {
+			return nil, err
+		}
+		sinks[name] = notifier
+	}
+
+	routes := make(map[AlertType][]string, len(cfg.Routes))
+	for alertType, sinkNames := range cfg.Routes {
+		for _, name := range sinkNames {
+			if _, ok := sinks[name]; !ok {
+				return nil, fmt.Errorf("route %q references undefined sink %q", alertType, name)
+			}
+		}
+		routes[AlertType(alertType)] = sinkNames
+	}
+
+	log.Printf("Loaded notifier config from %s: %d sink(s), %d route(s)", path, len(sinks), len(routes))
+	return &NotifierRouter{sinks: sinks, routes: routes}, nil
+}
+
+// defaultNotifierRouter builds a router that sends every alert type to a single Discord
+// webhook, preserving behavior for deployments that don't have a notifiers.yaml yet.
+func defaultNotifierRouter(discordWebhook string) *NotifierRouter {
+	discord := &DiscordNotifier{Webhook: discordWebhook}
+	return &NotifierRouter{
+		sinks: map[string]Notifier{"discord": discord},
+		routes: map[AlertType][]string{
+			AlertJailed:             {"discord"},
+			AlertInactive:           {"discord"},
+			AlertRecovery:           {"discord"},
+			AlertCommissionChange:   {"discord"},
+			AlertMonitoringDegraded: {"discord"},
+			AlertUnjailImminent:     {"discord"},
+			AlertValidatorMissing:   {"discord"},
+			AlertInfo:               {"discord"},
+		},
+	}
+}
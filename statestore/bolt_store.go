@@ -0,0 +1,126 @@
+package statestore
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// validatorsBucket is the single bucket keyed by validator address storing each
+// validator's JSON-encoded ValidatorState.
+var validatorsBucket = []byte("validators")
+
+// metaBucket stores the snapshot's schema version under metaSchemaVersionKey.
+var (
+	metaBucket           = []byte("meta")
+	metaSchemaVersionKey = []byte("schemaVersion")
+)
+
+// BoltStore persists the snapshot in a BoltDB file, useful for deployments that want
+// crash-safe writes without relying on filesystem rename semantics.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// NewBoltStore opens (creating if necessary) a BoltDB file at path.
+func NewBoltStore(path string) (*BoltStore, error) {
+	if err := ensureDir(path); err != nil {
+		return nil, fmt.Errorf("error creating directory for %s: %w", path, err)
+	}
+
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error opening bolt state file %s: %w", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(validatorsBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(metaBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error initializing bolt buckets: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) Load() (*Snapshot, error) {
+	snapshot := newEmptySnapshot()
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if meta := tx.Bucket(metaBucket); meta != nil {
+			if raw := meta.Get(metaSchemaVersionKey); raw != nil {
+				var version int
+				if err := json.Unmarshal(raw, &version); err != nil {
+					return fmt.Errorf("error parsing schema version: %w", err)
+				}
+				snapshot.SchemaVersion = version
+			}
+		}
+
+		validators := tx.Bucket(validatorsBucket)
+		if validators == nil {
+			return nil
+		}
+		return validators.ForEach(func(address, raw []byte) error {
+			var vs ValidatorState
+			if err := json.Unmarshal(raw, &vs); err != nil {
+				return fmt.Errorf("error parsing state for %s: %w", address, err)
+			}
+			snapshot.Validators[string(address)] = vs
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := migrate(snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Save overwrites the validators bucket with exactly the contents of snapshot, so
+// validators removed from configuration since the last save don't linger forever.
+func (s *BoltStore) Save(snapshot *Snapshot) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(validatorsBucket); err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+		validators, err := tx.CreateBucket(validatorsBucket)
+		if err != nil {
+			return err
+		}
+
+		for address, vs := range snapshot.Validators {
+			data, err := json.Marshal(vs)
+			if err != nil {
+				return fmt.Errorf("error marshaling state for %s: %w", address, err)
+			}
+			if err := validators.Put([]byte(address), data); err != nil {
+				return err
+			}
+		}
+
+		meta, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return err
+		}
+		versionData, err := json.Marshal(snapshot.SchemaVersion)
+		if err != nil {
+			return err
+		}
+		return meta.Put(metaSchemaVersionKey, versionData)
+	})
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,148 @@
+// Package statestore persists per-validator monitoring state (jailed/active status,
+// last-seen commission, and notification backoff timers) across process restarts, so a
+// container restart doesn't reset backoff timers or cause spurious recovery alerts.
+package statestore
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// CurrentSchemaVersion is bumped whenever the Snapshot shape changes in a way that
+// requires migrating previously-persisted data.
+const CurrentSchemaVersion = 1
+
+// Backoff mirrors the main package's NotificationBackoff for persistence.
+type Backoff struct {
+	LastSent      time.Time `json:"lastSent"`
+	BackoffFactor int       `json:"backoffFactor"`
+}
+
+// ValidatorState is the persisted view of a single validator's monitoring state.
+type ValidatorState struct {
+	IsJailed       bool   `json:"isJailed"`
+	IsActive       bool   `json:"isActive"`
+	Commission     string `json:"commission"`
+	UnjailImminent bool   `json:"unjailImminent"`
+	Missing        bool   `json:"missing"`
+	FirstRun       bool   `json:"firstRun"`
+
+	JailedBackoff         Backoff `json:"jailedBackoff"`
+	InactiveBackoff       Backoff `json:"inactiveBackoff"`
+	RecoveryBackoff       Backoff `json:"recoveryBackoff"`
+	CommissionBackoff     Backoff `json:"commissionBackoff"`
+	UnjailImminentBackoff Backoff `json:"unjailImminentBackoff"`
+	MissingBackoff        Backoff `json:"missingBackoff"`
+}
+
+// Snapshot is the full persisted state for every tracked validator.
+type Snapshot struct {
+	SchemaVersion int                       `json:"schemaVersion"`
+	Validators    map[string]ValidatorState `json:"validators"`
+}
+
+// newEmptySnapshot builds a fresh, current-schema snapshot for first runs.
+func newEmptySnapshot() *Snapshot {
+	return &Snapshot{
+		SchemaVersion: CurrentSchemaVersion,
+		Validators:    map[string]ValidatorState{},
+	}
+}
+
+// migrate upgrades an older snapshot to CurrentSchemaVersion in place. There is only one
+// schema version today; this is the hook future migrations attach to.
+func migrate(snapshot *Snapshot) error {
+	if snapshot.SchemaVersion > CurrentSchemaVersion {
+		return fmt.Errorf("state schema version %d is newer than supported version %d", snapshot.SchemaVersion, CurrentSchemaVersion)
+	}
+	if snapshot.Validators == nil {
+		snapshot.Validators = map[string]ValidatorState{}
+	}
+	snapshot.SchemaVersion = CurrentSchemaVersion
+	return nil
+}
+
+// Store persists and reloads a Snapshot. Save must not corrupt state if interrupted
+// mid-write. Callers serialize Save calls: JSONFileStore is not safe for concurrent Save
+// calls, since they all write through the same temp file.
+type Store interface {
+	Load() (*Snapshot, error)
+	Save(snapshot *Snapshot) error
+	Close() error
+}
+
+// NewStore builds a Store for the given backend ("json" or "bolt"/"boltdb"). An empty
+// backend defaults to "json".
+func NewStore(backend, path string) (Store, error) {
+	switch backend {
+	case "", "json":
+		return NewJSONFileStore(path), nil
+	case "bolt", "boltdb":
+		return NewBoltStore(path)
+	default:
+		return nil, fmt.Errorf("unknown state-backend %q", backend)
+	}
+}
+
+// JSONFileStore persists the snapshot as a single JSON file. It is the default backend
+// since it requires no extra runtime dependencies and is trivial to inspect by hand.
+type JSONFileStore struct {
+	path string
+}
+
+// NewJSONFileStore builds a JSONFileStore writing to path.
+func NewJSONFileStore(path string) *JSONFileStore {
+	return &JSONFileStore{path: path}
+}
+
+func (s *JSONFileStore) Load() (*Snapshot, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return newEmptySnapshot(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading state file %s: %w", s.path, err)
+	}
+
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, fmt.Errorf("error parsing state file %s: %w", s.path, err)
+	}
+	if err := migrate(&snapshot); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// Save writes snapshot to a temp file and renames it into place, so a crash mid-write
+// can't leave a truncated or corrupt state file behind.
+func (s *JSONFileStore) Save(snapshot *Snapshot) error {
+	data, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling state: %w", err)
+	}
+
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o644); err != nil {
+		return fmt.Errorf("error writing temp state file %s: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("error renaming temp state file into place: %w", err)
+	}
+	return nil
+}
+
+func (s *JSONFileStore) Close() error { return nil }
+
+// ensureDir creates the parent directory for path if it doesn't already exist, used by
+// backends (like BoltDB) that refuse to open a file in a missing directory.
+func ensureDir(path string) error {
+	dir := filepath.Dir(path)
+	if dir == "" || dir == "." {
+		return nil
+	}
+	return os.MkdirAll(dir, 0o755)
+}